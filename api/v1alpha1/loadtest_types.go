@@ -0,0 +1,214 @@
+/*
+ * Copyright (c) 2021-2022.
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0.
+ *
+ * If a copy of the MPL was not distributed with
+ * this file, You can obtain one at
+ *
+ *   http://mozilla.org/MPL/2.0/
+ */
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// SecretMount describes a Secret that should be mounted into the worker Pods,
+// e.g. to provide a users payload file referenced by Spec.UsersFile.
+type SecretMount struct {
+	// Name of the Secret to mount.
+	Name string `json:"name"`
+
+	// MountPoint is the path inside the worker container the Secret is mounted at.
+	MountPoint string `json:"mountPoint"`
+}
+
+// WorkloadType selects the Kubernetes workload kind used to run a LoadTest's workers.
+// +kubebuilder:validation:Enum=Job;CronJob;StatefulSet
+type WorkloadType string
+
+const (
+	// JobWorkload runs workers as a single batch/v1 Job. This is the default.
+	JobWorkload WorkloadType = "Job"
+
+	// CronJobWorkload runs workers as a batch/v1 CronJob on Spec.Schedule,
+	// for scheduled recurring load tests.
+	CronJobWorkload WorkloadType = "CronJob"
+
+	// StatefulSetWorkload runs workers as an apps/v1 StatefulSet, for
+	// long-running soak tests where worker identity must persist across
+	// restarts. No PersistentVolumeClaims are created for the workers.
+	StatefulSetWorkload WorkloadType = "StatefulSet"
+)
+
+// DriverType selects the backend a LoadTest's workers are provisioned on.
+// +kubebuilder:validation:Enum=Kubernetes;Docker
+type DriverType string
+
+const (
+	// KubernetesDriver runs workers as cluster workloads (see WorkloadType). This is the default.
+	KubernetesDriver DriverType = "Kubernetes"
+
+	// DockerDriver runs workers as local Docker containers, so a LoadTest can
+	// be dry-run on a developer laptop or in CI without a cluster.
+	DockerDriver DriverType = "Docker"
+)
+
+// CleanPodPolicy decides which finished worker Pods get deleted once their
+// outcome has been counted.
+// +kubebuilder:validation:Enum=None;OnCompletion;OnFailure;All
+type CleanPodPolicy string
+
+const (
+	// CleanPodPolicyNone keeps every finished Pod around. This is the default,
+	// preserving the behavior LoadTest had before CleanPodPolicy existed.
+	CleanPodPolicyNone CleanPodPolicy = "None"
+
+	// CleanPodPolicyOnCompletion deletes Pods that succeeded.
+	CleanPodPolicyOnCompletion CleanPodPolicy = "OnCompletion"
+
+	// CleanPodPolicyOnFailure deletes Pods that failed.
+	CleanPodPolicyOnFailure CleanPodPolicy = "OnFailure"
+
+	// CleanPodPolicyAll deletes every finished Pod, regardless of outcome.
+	CleanPodPolicyAll CleanPodPolicy = "All"
+)
+
+// LoadTestSpec defines the desired state of LoadTest
+type LoadTestSpec struct {
+	// Count is the number of workers (Job completions/parallelism) used to run the test.
+	// +optional
+	Count int `json:"count,omitempty"`
+
+	// WorkloadType selects the workload kind used to run the test's workers.
+	// Defaults to Job.
+	// +optional
+	WorkloadType WorkloadType `json:"workloadType,omitempty"`
+
+	// Schedule is a cron expression used when WorkloadType is CronJob.
+	// +optional
+	Schedule string `json:"schedule,omitempty"`
+
+	// Driver selects the backend the test's workers run on. Defaults to
+	// Kubernetes; Docker runs workers as local containers for developer
+	// laptops and CI, using the same LoadTest CR.
+	// +optional
+	Driver DriverType `json:"driver,omitempty"`
+
+	// CleanPodPolicy decides whether finished worker Pods are kept around or
+	// deleted once their outcome has been counted. Defaults to None, which
+	// preserves every Pod so artillery report/log output stays inspectable.
+	// +optional
+	CleanPodPolicy CleanPodPolicy `json:"cleanPodPolicy,omitempty"`
+
+	// TTLSecondsAfterFinished mirrors batch/v1 Job's field of the same name:
+	// the Job (and its Pods) are deleted this many seconds after it finishes.
+	// +optional
+	TTLSecondsAfterFinished *int32 `json:"ttlSecondsAfterFinished,omitempty"`
+
+	// OwnerRef optionally points to another resource (e.g. a parent CronJob
+	// or Argo Workflow) that already manages the lifecycle of this test's
+	// worker workload. When set, the reconciler never creates the workload
+	// itself - it only adopts and observes whatever OwnerRef's controller
+	// creates, to avoid a duplicate Job fighting over the same Pods.
+	// +optional
+	OwnerRef *corev1.ObjectReference `json:"ownerRef,omitempty"`
+
+	// Image overrides the default Artillery worker image.
+	// +optional
+	Image string `json:"image,omitempty"`
+
+	// Args are passed to the Artillery worker container.
+	// +optional
+	Args []string `json:"args,omitempty"`
+
+	// Resources overrides the default worker Pod resource requirements.
+	// +optional
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+
+	// SecretEnvSource names a Secret whose keys are injected as environment
+	// variables into the worker containers.
+	// +optional
+	SecretEnvSource string `json:"secretEnvSource,omitempty"`
+
+	// SecretMount mounts a Secret into the worker containers, typically to
+	// supply a UsersFile payload.
+	// +optional
+	SecretMount *SecretMount `json:"secretMount,omitempty"`
+
+	// UsersFile is the name of the file inside SecretMount holding the users payload.
+	// +optional
+	UsersFile string `json:"usersFile,omitempty"`
+}
+
+// UncountedTerminatedPods holds the UIDs of Pods that have finished (Succeeded
+// or Failed) but whose outcome has not yet been folded into Status.Succeeded
+// or Status.Failed. It mirrors the batch/v1 Job field of the same name that
+// backs the pod-tracking-with-finalizers feature: a worker's finalizer is only
+// removed once its UID has been recorded here, and the UID only moves out of
+// here and into the corresponding counter after that finalizer removal, so a
+// crash between "pod finished" and "counter incremented" can neither
+// undercount nor double-count workers.
+type UncountedTerminatedPods struct {
+	// Succeeded holds UIDs of succeeded Pods not yet counted in Status.Succeeded.
+	// +optional
+	Succeeded []types.UID `json:"succeeded,omitempty"`
+
+	// Failed holds UIDs of failed Pods not yet counted in Status.Failed.
+	// +optional
+	Failed []types.UID `json:"failed,omitempty"`
+}
+
+// LoadTestStatus defines the observed state of LoadTest
+type LoadTestStatus struct {
+	// Active is the number of worker Pods that are currently running.
+	// +optional
+	Active int32 `json:"active,omitempty"`
+
+	// Succeeded is the number of worker Pods that have completed successfully.
+	// +optional
+	Succeeded int32 `json:"succeeded,omitempty"`
+
+	// Failed is the number of worker Pods that have terminated in failure.
+	// +optional
+	Failed int32 `json:"failed,omitempty"`
+
+	// Ready is the number of worker Pods that have a Ready condition.
+	// +optional
+	Ready int32 `json:"ready,omitempty"`
+
+	// UncountedTerminatedPods tracks terminated worker Pods whose outcome is
+	// not yet reflected in Succeeded/Failed above.
+	// +optional
+	UncountedTerminatedPods *UncountedTerminatedPods `json:"uncountedTerminatedPods,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// LoadTest is the Schema for the loadtests API
+type LoadTest struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LoadTestSpec   `json:"spec,omitempty"`
+	Status LoadTestStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LoadTestList contains a list of LoadTest
+type LoadTestList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LoadTest `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&LoadTest{}, &LoadTestList{})
+}
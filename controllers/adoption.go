@@ -0,0 +1,103 @@
+/*
+ * Copyright (c) 2021-2022.
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0.
+ *
+ * If a copy of the MPL was not distributed with
+ * this file, You can obtain one at
+ *
+ *   http://mozilla.org/MPL/2.0/
+ */
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// errWaitingForOwnerAdoption is returned while Spec.OwnerRef names another
+// controller (e.g. a parent CronJob or Argo Workflow) that hasn't created
+// the owned workload yet. Reconcile treats it as a quiet, periodic retry
+// rather than a logged error, since there's no way to know in advance when
+// the external controller will get around to creating it.
+var errWaitingForOwnerAdoption = errors.New("waiting for externally-owned workload to appear")
+
+// ownerAdoptionRequeueInterval is how often Reconcile checks back while
+// waiting for Spec.OwnerRef's controller to create the owned workload.
+const ownerAdoptionRequeueInterval = 15 * time.Second
+
+// ownedByRef reports whether refs contains an owner reference matching
+// owner. It prefers a UID match (authoritative) and falls back to Kind+Name
+// when owner has no UID set, since Spec.OwnerRef is hand-written by the
+// LoadTest's author and may only identify the owner by name.
+func ownedByRef(refs []metav1.OwnerReference, owner *corev1.ObjectReference) bool {
+	for _, ref := range refs {
+		if owner.UID != "" {
+			if ref.UID == owner.UID {
+				return true
+			}
+			continue
+		}
+		if ref.Kind == owner.Kind && ref.Name == owner.Name {
+			return true
+		}
+	}
+	return false
+}
+
+// findOwnedJob returns the Job in namespace already owned by owner, or nil
+// if the external controller named by owner hasn't created one yet. Jobs
+// created by a parent CronJob/Argo Workflow get generated names, so
+// adoption can't key off an exact name match the way a self-created Job does.
+func findOwnedJob(ctx context.Context, c client.Client, namespace string, owner *corev1.ObjectReference) (*v1.Job, error) {
+	var jobs v1.JobList
+	if err := c.List(ctx, &jobs, client.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+
+	for i := range jobs.Items {
+		if ownedByRef(jobs.Items[i].OwnerReferences, owner) {
+			return &jobs.Items[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// findOwnedCronJob is findOwnedJob for the CronJob workload kind.
+func findOwnedCronJob(ctx context.Context, c client.Client, namespace string, owner *corev1.ObjectReference) (*v1.CronJob, error) {
+	var cronJobs v1.CronJobList
+	if err := c.List(ctx, &cronJobs, client.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+
+	for i := range cronJobs.Items {
+		if ownedByRef(cronJobs.Items[i].OwnerReferences, owner) {
+			return &cronJobs.Items[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// findOwnedStatefulSet is findOwnedJob for the StatefulSet workload kind.
+func findOwnedStatefulSet(ctx context.Context, c client.Client, namespace string, owner *corev1.ObjectReference) (*appsv1.StatefulSet, error) {
+	var statefulSets appsv1.StatefulSetList
+	if err := c.List(ctx, &statefulSets, client.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+
+	for i := range statefulSets.Items {
+		if ownedByRef(statefulSets.Items[i].OwnerReferences, owner) {
+			return &statefulSets.Items[i], nil
+		}
+	}
+	return nil, nil
+}
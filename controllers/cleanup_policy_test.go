@@ -0,0 +1,45 @@
+/*
+ * Copyright (c) 2021-2022.
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0.
+ *
+ * If a copy of the MPL was not distributed with
+ * this file, You can obtain one at
+ *
+ *   http://mozilla.org/MPL/2.0/
+ */
+
+package controllers
+
+import (
+	"testing"
+
+	lt "github.com/artilleryio/artillery-operator/api/v1alpha1"
+)
+
+func TestShouldCleanUpPod(t *testing.T) {
+	tests := map[string]struct {
+		policy    lt.CleanPodPolicy
+		succeeded bool
+		want      bool
+	}{
+		"None keeps a succeeded Pod":          {lt.CleanPodPolicyNone, true, false},
+		"None keeps a failed Pod":             {lt.CleanPodPolicyNone, false, false},
+		"unset defaults to None":              {"", true, false},
+		"OnCompletion deletes succeeded":       {lt.CleanPodPolicyOnCompletion, true, true},
+		"OnCompletion keeps failed":           {lt.CleanPodPolicyOnCompletion, false, false},
+		"OnFailure deletes failed":             {lt.CleanPodPolicyOnFailure, false, true},
+		"OnFailure keeps succeeded":            {lt.CleanPodPolicyOnFailure, true, false},
+		"All deletes succeeded":                {lt.CleanPodPolicyAll, true, true},
+		"All deletes failed":                   {lt.CleanPodPolicyAll, false, true},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := shouldCleanUpPod(tc.policy, tc.succeeded); got != tc.want {
+				t.Errorf("shouldCleanUpPod(%q, %v) = %v, want %v", tc.policy, tc.succeeded, got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+/*
+ * Copyright (c) 2021-2022.
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0.
+ *
+ * If a copy of the MPL was not distributed with
+ * this file, You can obtain one at
+ *
+ *   http://mozilla.org/MPL/2.0/
+ */
+
+package controllers
+
+import (
+	"context"
+
+	lt "github.com/artilleryio/artillery-operator/api/v1alpha1"
+	"github.com/go-logr/logr"
+	v1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8error "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// cronJobBuilder is the workloadBuilder for Spec.WorkloadType == CronJob: it
+// runs the same worker Pod template on a recurring Spec.Schedule, so a single
+// LoadTest CR can drive nightly regression runs without external cron
+// machinery.
+type cronJobBuilder struct {
+	r *LoadTestReconciler
+}
+
+// cronJob creates a CronJob spec based on the LoadTest Custom Resource. Each
+// scheduled run creates a Job identical in shape to the one jobBuilder would
+// create directly.
+func (b *cronJobBuilder) cronJob(v *lt.LoadTest, logger logr.Logger) *v1.CronJob {
+	var (
+		parallelism  int32 = 1
+		completions  int32 = 1
+		backoffLimit int32 = 0
+	)
+
+	if v.Spec.Count > 0 {
+		parallelism = int32(v.Spec.Count)
+		completions = int32(v.Spec.Count)
+	}
+	var completion v1.CompletionMode = v1.IndexedCompletion
+
+	cronJob := &v1.CronJob{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      v.Name,
+			Namespace: v.Namespace,
+			Labels:    labels(v, "loadtest-worker-master"),
+		},
+		Spec: v1.CronJobSpec{
+			Schedule: v.Spec.Schedule,
+			JobTemplate: v1.JobTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: labels(v, "loadtest-worker-master"),
+				},
+				Spec: v1.JobSpec{
+					Parallelism:             &parallelism,
+					Completions:             &completions,
+					CompletionMode:          &completion,
+					BackoffLimit:            &backoffLimit,
+					TTLSecondsAfterFinished: v.Spec.TTLSecondsAfterFinished,
+					Template:                workerPodTemplateSpec(v, logger, b.r.TelemetryConfig, corev1.RestartPolicyNever),
+				},
+			},
+		},
+	}
+
+	_ = ctrl.SetControllerReference(v, cronJob, b.r.Scheme)
+	return cronJob
+}
+
+func (b *cronJobBuilder) Ensure(ctx context.Context, instance *lt.LoadTest, logger logr.Logger) (*reconcile.Result, error) {
+	cronJob := b.cronJob(instance, logger)
+
+	found := &v1.CronJob{}
+	err := b.r.Get(ctx, types.NamespacedName{Name: cronJob.Name, Namespace: instance.Namespace}, found)
+
+	if err != nil && k8error.IsNotFound(err) {
+		if instance.Spec.OwnerRef != nil {
+			owned, findErr := findOwnedCronJob(ctx, b.r.Client, instance.Namespace, instance.Spec.OwnerRef)
+			if findErr != nil {
+				logger.Error(findErr, "Failed to list CronJobs for adoption", "OwnerRef", instance.Spec.OwnerRef)
+				return &ctrl.Result{}, findErr
+			}
+			if owned == nil {
+				return nil, errWaitingForOwnerAdoption
+			}
+
+			b.r.Recorder.Eventf(instance, "Normal", "Adopted", "Adopted externally-owned CronJob: %s", owned.Name)
+			return nil, nil
+		}
+
+		logger.Info("Creating a new CronJob", "CronJob.Namespace", cronJob.Namespace, "CronJob.Name", cronJob.Name)
+
+		if err := b.r.Create(ctx, cronJob); err != nil {
+			logger.Error(err, "Failed to create new CronJob", "CronJob.Namespace", cronJob.Namespace, "CronJob.Name", cronJob.Name)
+			return &ctrl.Result{}, err
+		}
+
+		b.r.Recorder.Eventf(instance, "Normal", "Created", "Created Load Test worker CronJob: %s", cronJob.Name)
+		return nil, nil
+	} else if err != nil {
+		logger.Error(err, "Failed to get CronJob", "CronJob.Namespace", cronJob.Namespace, "CronJob.Name", cronJob.Name)
+		return &ctrl.Result{}, err
+	}
+
+	return nil, nil
+}
+
+func (b *cronJobBuilder) Teardown(ctx context.Context, instance *lt.LoadTest, logger logr.Logger) error {
+	cronJob := &v1.CronJob{}
+	err := b.r.Get(ctx, types.NamespacedName{Name: instance.Name, Namespace: instance.Namespace}, cronJob)
+	if k8error.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	propagation := metav1.DeletePropagationBackground
+	if err := b.r.Delete(ctx, cronJob, &client.DeleteOptions{PropagationPolicy: &propagation}); err != nil && !k8error.IsNotFound(err) {
+		logger.Error(err, "Failed to delete CronJob", "CronJob.Name", cronJob.Name)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,145 @@
+/*
+ * Copyright (c) 2021-2022.
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0.
+ *
+ * If a copy of the MPL was not distributed with
+ * this file, You can obtain one at
+ *
+ *   http://mozilla.org/MPL/2.0/
+ */
+
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os/exec"
+	"strings"
+
+	lt "github.com/artilleryio/artillery-operator/api/v1alpha1"
+	"github.com/artilleryio/artillery-operator/pkg/executor"
+)
+
+// dockerExecutor is the Executor for Spec.Driver == Docker: it runs workers
+// as local containers via the docker CLI, so a LoadTest CR can be dry-run on
+// a developer laptop or in CI without a cluster. Containers are tagged with
+// the same "artillery.io/test-name" label used to scope Kubernetes workers,
+// so Status/Teardown can find them again from the Handle alone.
+type dockerExecutor struct{}
+
+func (e *dockerExecutor) containerName(v *lt.LoadTest, worker int) string {
+	return fmt.Sprintf("%s-worker-%d", v.Name, worker)
+}
+
+func (e *dockerExecutor) Provision(ctx context.Context, v *lt.LoadTest) (executor.Handle, error) {
+	count := v.Spec.Count
+	if count <= 0 {
+		count = 1
+	}
+
+	img := WorkerImage
+	if v.Spec.Image != "" {
+		img = v.Spec.Image
+	}
+
+	args := []string{"help"}
+	if v.Spec.Args != nil {
+		args = v.Spec.Args
+	}
+
+	for i := 0; i < count; i++ {
+		name := e.containerName(v, i)
+
+		runArgs := []string{
+			"run", "-d",
+			"--name", name,
+			"--label", "artillery.io/test-name=" + v.Name,
+			"-e", "WORKER_ID=" + name,
+		}
+		runArgs = append(runArgs, img)
+		runArgs = append(runArgs, args...)
+
+		cmd := exec.CommandContext(ctx, "docker", runArgs...)
+		if out, err := cmd.CombinedOutput(); err != nil && !strings.Contains(string(out), "is already in use") {
+			return executor.Handle{}, fmt.Errorf("docker run %s: %w: %s", name, err, out)
+		}
+	}
+
+	return executor.Handle{Namespace: v.Namespace, Name: v.Name}, nil
+}
+
+func (e *dockerExecutor) Status(handle executor.Handle) (executor.ExecutorStatus, error) {
+	cmd := exec.Command("docker", "ps", "-a",
+		"--filter", "label=artillery.io/test-name="+handle.Name,
+		"--format", "{{.Status}}")
+
+	out, err := cmd.Output()
+	if err != nil {
+		return executor.ExecutorStatus{}, fmt.Errorf("docker ps: %w", err)
+	}
+
+	return parseDockerPSStatus(string(out)), nil
+}
+
+// parseDockerPSStatus turns the output of `docker ps --format {{.Status}}`
+// (one container per line, e.g. "Up 2 minutes", "Exited (0) 3 seconds ago")
+// into worker counts. Kept separate from Status so the parsing can be
+// exercised without shelling out to docker.
+func parseDockerPSStatus(out string) executor.ExecutorStatus {
+	var status executor.ExecutorStatus
+	for _, line := range strings.Split(strings.TrimSpace(out), "\n") {
+		switch {
+		case line == "":
+			continue
+		case strings.HasPrefix(line, "Up"):
+			status.Active++
+			status.Ready++
+		case strings.HasPrefix(line, "Exited (0)"):
+			status.Succeeded++
+		case strings.HasPrefix(line, "Exited"):
+			status.Failed++
+		}
+	}
+
+	return status
+}
+
+func (e *dockerExecutor) Logs(handle executor.Handle, worker executor.WorkerID) (io.ReadCloser, error) {
+	cmd := exec.Command("docker", "logs", string(worker))
+
+	var out bytes.Buffer
+	cmd.Stdout = &out
+	cmd.Stderr = &out
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("docker logs %s: %w", worker, err)
+	}
+
+	return io.NopCloser(bytes.NewReader(out.Bytes())), nil
+}
+
+func (e *dockerExecutor) Teardown(handle executor.Handle) error {
+	listCmd := exec.Command("docker", "ps", "-aq",
+		"--filter", "label=artillery.io/test-name="+handle.Name)
+
+	ids, err := listCmd.Output()
+	if err != nil {
+		return fmt.Errorf("docker ps: %w", err)
+	}
+
+	containers := strings.Fields(string(ids))
+	if len(containers) == 0 {
+		return nil
+	}
+
+	rmArgs := append([]string{"rm", "-f"}, containers...)
+	if out, err := exec.Command("docker", rmArgs...).CombinedOutput(); err != nil {
+		return fmt.Errorf("docker rm: %w: %s", err, out)
+	}
+
+	return nil
+}
+
@@ -0,0 +1,55 @@
+/*
+ * Copyright (c) 2021-2022.
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0.
+ *
+ * If a copy of the MPL was not distributed with
+ * this file, You can obtain one at
+ *
+ *   http://mozilla.org/MPL/2.0/
+ */
+
+package controllers
+
+import (
+	"testing"
+
+	"github.com/artilleryio/artillery-operator/pkg/executor"
+)
+
+func TestParseDockerPSStatus(t *testing.T) {
+	tests := map[string]struct {
+		out  string
+		want executor.ExecutorStatus
+	}{
+		"empty output": {
+			out:  "",
+			want: executor.ExecutorStatus{},
+		},
+		"mixed running, succeeded and failed": {
+			out: "Up 2 minutes\n" +
+				"Exited (0) 3 seconds ago\n" +
+				"Exited (1) 10 seconds ago\n" +
+				"Up 5 minutes\n",
+			want: executor.ExecutorStatus{Active: 2, Ready: 2, Succeeded: 1, Failed: 1},
+		},
+		"trailing blank line is ignored": {
+			out:  "Up 1 second\n\n",
+			want: executor.ExecutorStatus{Active: 1, Ready: 1},
+		},
+		"restarting container counts as neither active nor terminal": {
+			out:  "Restarting (1) 5 seconds ago\n",
+			want: executor.ExecutorStatus{},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := parseDockerPSStatus(tc.out)
+			if got != tc.want {
+				t.Errorf("parseDockerPSStatus(%q) = %+v, want %+v", tc.out, got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+/*
+ * Copyright (c) 2021-2022.
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0.
+ *
+ * If a copy of the MPL was not distributed with
+ * this file, You can obtain one at
+ *
+ *   http://mozilla.org/MPL/2.0/
+ */
+
+package controllers
+
+import (
+	"context"
+	"io"
+
+	lt "github.com/artilleryio/artillery-operator/api/v1alpha1"
+	"github.com/artilleryio/artillery-operator/pkg/executor"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// kubernetesExecutor is the default Executor: it runs a LoadTest's workers as
+// a cluster workload via workloadBuilder, the same code path used before
+// Executor existed.
+type kubernetesExecutor struct {
+	r *LoadTestReconciler
+}
+
+func (e *kubernetesExecutor) Provision(ctx context.Context, v *lt.LoadTest) (executor.Handle, error) {
+	logger := ctrl.LoggerFrom(ctx)
+
+	builder := e.r.workloadBuilderFor(v)
+	if _, err := builder.Ensure(ctx, v, logger); err != nil {
+		return executor.Handle{}, err
+	}
+
+	return executor.Handle{Namespace: v.Namespace, Name: v.Name}, nil
+}
+
+func (e *kubernetesExecutor) Status(handle executor.Handle) (executor.ExecutorStatus, error) {
+	ctx := context.Background()
+	logger := ctrl.Log.WithName("kubernetes-executor")
+
+	instance := &lt.LoadTest{}
+	if err := e.r.Get(ctx, types.NamespacedName{Name: handle.Name, Namespace: handle.Namespace}, instance); err != nil {
+		return executor.ExecutorStatus{}, err
+	}
+
+	if err := e.r.reconcileWorkerPods(ctx, instance, logger); err != nil {
+		return executor.ExecutorStatus{}, err
+	}
+
+	return executor.ExecutorStatus{
+		Active:    instance.Status.Active,
+		Succeeded: instance.Status.Succeeded,
+		Failed:    instance.Status.Failed,
+		Ready:     instance.Status.Ready,
+	}, nil
+}
+
+// Logs streams the log of a single worker Pod. It requires a typed clientset
+// (LoadTestReconciler.ClientSet) since the controller-runtime client used
+// elsewhere doesn't support the Pod log subresource.
+func (e *kubernetesExecutor) Logs(handle executor.Handle, worker executor.WorkerID) (io.ReadCloser, error) {
+	return e.r.ClientSet.CoreV1().Pods(handle.Namespace).
+		GetLogs(string(worker), &corev1.PodLogOptions{}).
+		Stream(context.Background())
+}
+
+func (e *kubernetesExecutor) Teardown(handle executor.Handle) error {
+	ctx := context.Background()
+
+	instance := &lt.LoadTest{}
+	if err := e.r.Get(ctx, types.NamespacedName{Name: handle.Name, Namespace: handle.Namespace}, instance); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+
+	return e.r.workloadBuilderFor(instance).Teardown(ctx, instance, ctrl.Log.WithName("kubernetes-executor"))
+}
@@ -15,8 +15,6 @@ package controllers
 //goland:noinspection SpellCheckingInspection
 import (
 	"context"
-	"errors"
-	"fmt"
 
 	lt "github.com/artilleryio/artillery-operator/api/v1alpha1"
 	"github.com/go-logr/logr"
@@ -28,6 +26,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
@@ -48,8 +47,11 @@ func MergePreservingExistingKeys(dest, src map[corev1.ResourceName]resource.Quan
 	return dest
 }
 
-// ensureJob creates a Job that in turn creates the required worker Pods
-// to run load tests using an Artillery image.
+// ensureJob makes sure job exists in the cluster, creating it if needed. The
+// job pointer is updated in place with the server-assigned fields (UID,
+// ResourceVersion, ...) of whichever Job object is live in the cluster, so
+// callers can rely on job.UID afterwards regardless of whether it was just
+// created or already existed.
 func (r *LoadTestReconciler) ensureJob(
 	ctx context.Context,
 	instance *lt.LoadTest,
@@ -64,6 +66,27 @@ func (r *LoadTestReconciler) ensureJob(
 	}, found)
 
 	if err != nil && k8error.IsNotFound(err) {
+		if instance.Spec.OwnerRef != nil {
+			// instance.Spec.OwnerRef names another controller (e.g. a parent
+			// CronJob or Argo Workflow) responsible for this Job's lifecycle.
+			// Creating one ourselves would fight that controller over the
+			// same Pods, so we only adopt/observe what it creates. Jobs it
+			// creates get generated names, so adoption is keyed off
+			// ownerReferences rather than job.Name.
+			owned, findErr := findOwnedJob(ctx, r.Client, instance.Namespace, instance.Spec.OwnerRef)
+			if findErr != nil {
+				logger.Error(findErr, "Failed to list Jobs for adoption", "OwnerRef", instance.Spec.OwnerRef)
+				return &ctrl.Result{}, findErr
+			}
+			if owned == nil {
+				return nil, errWaitingForOwnerAdoption
+			}
+
+			r.Recorder.Eventf(instance, "Normal", "Adopted", "Adopted externally-owned Job: %s", owned.Name)
+			owned.DeepCopyInto(job)
+			return nil, nil
+		}
+
 		// Create a new job
 		logger.Info("Creating a new Job", "Job.Namespace", job.Namespace, "Job.Name", job.Name)
 
@@ -84,6 +107,7 @@ func (r *LoadTestReconciler) ensureJob(
 	}
 
 	// job found successfully
+	found.DeepCopyInto(job)
 	return nil, nil
 }
 
@@ -100,88 +124,7 @@ func (r *LoadTestReconciler) job(v *lt.LoadTest, logger logr.Logger) *v1.Job {
 		parallelism = int32(v.Spec.Count)
 		completions = int32(v.Spec.Count)
 	}
-	img := WorkerImage
-
-	resources := corev1.ResourceRequirements{
-		Limits: corev1.ResourceList{
-			corev1.ResourceCPU:    resource.MustParse("2"),
-			corev1.ResourceMemory: resource.MustParse("4Gi"),
-		},
-		Requests: corev1.ResourceList{
-			corev1.ResourceCPU:    resource.MustParse("2"),
-			corev1.ResourceMemory: resource.MustParse("2Gi"),
-		},
-	}
-	if v.Spec.Resources != nil && v.Spec.Resources.Limits != nil {
-		resources.Limits = MergePreservingExistingKeys(v.Spec.Resources.Limits, resources.Limits)
-	}
-	if v.Spec.Resources != nil && v.Spec.Resources.Requests != nil {
-		resources.Requests = MergePreservingExistingKeys(v.Spec.Resources.Requests, resources.Requests)
-	}
-
-	if v.Spec.Image != "" {
-		img = v.Spec.Image
-	}
-
-	args := []string{
-		"help",
-	}
-
-	if v.Spec.Args != nil {
-		args = v.Spec.Args
-	}
 	var completion v1.CompletionMode = v1.IndexedCompletion
-	secrets := []corev1.EnvFromSource{}
-
-	if v.Spec.SecretEnvSource != "" {
-		secrets = append(secrets, corev1.EnvFromSource{
-			SecretRef: &corev1.SecretEnvSource{
-				LocalObjectReference: corev1.LocalObjectReference{
-					Name: v.Spec.SecretEnvSource,
-				},
-			},
-		})
-	}
-
-	volumes := []corev1.Volume{}
-	volumeMounts := []corev1.VolumeMount{}
-
-	envVars := []corev1.EnvVar{
-		// published metrics use WORKER_ID to connect the pod (worker) to a Pushgateway JobID
-		// Uses the downward API:
-		// https://kubernetes.io/docs/tasks/inject-data-application/downward-api-volume-expose-pod-information/#the-downward-api
-		{
-			Name: "WORKER_ID",
-			ValueFrom: &corev1.EnvVarSource{
-				FieldRef: &corev1.ObjectFieldSelector{
-					FieldPath: "metadata.name",
-				},
-			},
-		}}
-
-	if v.Spec.SecretMount != nil {
-		sm := *v.Spec.SecretMount
-		volumes = append(volumes, corev1.Volume{
-			Name: sm.Name,
-			VolumeSource: corev1.VolumeSource{
-				Secret: &corev1.SecretVolumeSource{
-					SecretName: sm.Name,
-				},
-			},
-		})
-		if v.Spec.UsersFile == "" {
-			logger.Error(errors.New("You need to specify the UsersFile when mounting a SecretsMount"), "")
-		}
-		volumeMounts = append(volumeMounts, corev1.VolumeMount{
-			Name:      sm.Name,
-			MountPath: sm.MountPoint,
-		})
-		envVars = append(envVars, corev1.EnvVar{
-			Name:  "USERS_PAYLOAD_PATH",
-			Value: fmt.Sprintf("%s/%s", sm.MountPoint, v.Spec.UsersFile),
-		})
-
-	}
 
 	job := &v1.Job{
 		ObjectMeta: metav1.ObjectMeta{
@@ -190,35 +133,12 @@ func (r *LoadTestReconciler) job(v *lt.LoadTest, logger logr.Logger) *v1.Job {
 			Labels:    labels(v, "loadtest-worker-master"),
 		},
 		Spec: v1.JobSpec{
-			Parallelism:    &parallelism,
-			Completions:    &completions,
-			CompletionMode: &completion,
-			BackoffLimit:   &backoffLimit,
-			Template: corev1.PodTemplateSpec{
-				ObjectMeta: metav1.ObjectMeta{
-					Labels: labels(v, "loadtest-worker"),
-				},
-
-				Spec: corev1.PodSpec{
-					Containers: []corev1.Container{
-						{
-							Name:            v.Name,
-							Image:           img,
-							ImagePullPolicy: corev1.PullIfNotPresent,
-							Resources:       resources,
-							Args:            args,
-							EnvFrom:         secrets,
-							Env: append(envVars,
-								r.TelemetryConfig.ToK8sEnvVar()...,
-							),
-							VolumeMounts: volumeMounts,
-						},
-					},
-					// Provides access to the ConfigMap holding the test script config
-					RestartPolicy: "Never",
-					Volumes:       volumes,
-				},
-			},
+			Parallelism:             &parallelism,
+			Completions:             &completions,
+			CompletionMode:          &completion,
+			BackoffLimit:            &backoffLimit,
+			TTLSecondsAfterFinished: v.Spec.TTLSecondsAfterFinished,
+			Template:                workerPodTemplateSpec(v, logger, r.TelemetryConfig, corev1.RestartPolicyNever),
 		},
 	}
 
@@ -226,6 +146,34 @@ func (r *LoadTestReconciler) job(v *lt.LoadTest, logger logr.Logger) *v1.Job {
 	return job
 }
 
+// jobBuilder is the workloadBuilder for Spec.WorkloadType == Job (or unset),
+// the original and default way to run a LoadTest's workers.
+type jobBuilder struct {
+	r *LoadTestReconciler
+}
+
+func (b *jobBuilder) Ensure(ctx context.Context, instance *lt.LoadTest, logger logr.Logger) (*reconcile.Result, error) {
+	return b.r.ensureJob(ctx, instance, logger, b.r.job(instance, logger))
+}
+
+func (b *jobBuilder) Teardown(ctx context.Context, instance *lt.LoadTest, logger logr.Logger) error {
+	job := &v1.Job{}
+	err := b.r.Get(ctx, types.NamespacedName{Name: instance.Name, Namespace: instance.Namespace}, job)
+	if k8error.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	propagation := metav1.DeletePropagationBackground
+	if err := b.r.Delete(ctx, job, &client.DeleteOptions{PropagationPolicy: &propagation}); err != nil && !k8error.IsNotFound(err) {
+		logger.Error(err, "Failed to delete Job", "Job.Name", job.Name)
+		return err
+	}
+
+	return nil
+}
+
 // labels creates K8s labels used to organize
 // and categorize (scope and select) Load Test objects.
 func labels(v *lt.LoadTest, component string) map[string]string {
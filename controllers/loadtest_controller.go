@@ -0,0 +1,202 @@
+/*
+ * Copyright (c) 2021-2022.
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0.
+ *
+ * If a copy of the MPL was not distributed with
+ * this file, You can obtain one at
+ *
+ *   http://mozilla.org/MPL/2.0/
+ */
+
+package controllers
+
+import (
+	"context"
+	"errors"
+
+	lt "github.com/artilleryio/artillery-operator/api/v1alpha1"
+	"github.com/artilleryio/artillery-operator/pkg/config"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+)
+
+// WorkerImage is the default Artillery image used to run load test workers
+// when Spec.Image is not set.
+const WorkerImage = "artilleryio/artillery:latest"
+
+// TelemetryConfig holds operator-wide telemetry settings that are threaded
+// into every worker Pod as environment variables.
+type TelemetryConfig struct {
+	Disabled bool
+}
+
+// ToK8sEnvVar renders the telemetry configuration as container environment variables.
+func (c TelemetryConfig) ToK8sEnvVar() []corev1.EnvVar {
+	if !c.Disabled {
+		return nil
+	}
+
+	return []corev1.EnvVar{
+		{
+			Name:  "ARTILLERY_DISABLE_TELEMETRY",
+			Value: "true",
+		},
+	}
+}
+
+// LoadTestReconciler reconciles a LoadTest object
+type LoadTestReconciler struct {
+	client.Client
+	Scheme          *runtime.Scheme
+	Recorder        record.EventRecorder
+	TelemetryConfig TelemetryConfig
+
+	// ClientSet is a typed Kubernetes clientset used for APIs the
+	// controller-runtime client doesn't cover, such as streaming Pod logs.
+	ClientSet kubernetes.Interface
+
+	// PodIntegration gates which namespaces/Pods this operator instance is
+	// allowed to manage, for running cluster-wide with limited tenants.
+	PodIntegration config.PodIntegrationOptions
+}
+
+//+kubebuilder:rbac:groups=artillery.io,resources=loadtests,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=artillery.io,resources=loadtests/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=artillery.io,resources=loadtests/finalizers,verbs=update
+//+kubebuilder:rbac:groups=batch,resources=jobs;cronjobs,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=apps,resources=statefulsets,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=core,resources=pods,verbs=get;list;watch;update;patch;delete
+//+kubebuilder:rbac:groups=core,resources=pods/log,verbs=get
+
+// Reconcile brings the cluster state for a LoadTest closer to the desired state.
+func (r *LoadTestReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := ctrl.LoggerFrom(ctx)
+
+	instance := &lt.LoadTest{}
+	if err := r.Get(ctx, req.NamespacedName, instance); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if instance.DeletionTimestamp != nil {
+		if controllerutil.ContainsFinalizer(instance, LoadTestFinalizer) {
+			if err := r.releaseWorkerPods(ctx, instance, logger); err != nil {
+				return ctrl.Result{}, err
+			}
+
+			controllerutil.RemoveFinalizer(instance, LoadTestFinalizer)
+			if err := r.Update(ctx, instance); err != nil {
+				logger.Error(err, "Failed to remove LoadTest finalizer", "LoadTest.Name", instance.Name)
+				return ctrl.Result{}, err
+			}
+		}
+		return ctrl.Result{}, nil
+	}
+
+	if !controllerutil.ContainsFinalizer(instance, LoadTestFinalizer) {
+		controllerutil.AddFinalizer(instance, LoadTestFinalizer)
+		if err := r.Update(ctx, instance); err != nil {
+			logger.Error(err, "Failed to add LoadTest finalizer", "LoadTest.Name", instance.Name)
+			return ctrl.Result{}, err
+		}
+	}
+
+	admitted, err := r.admitted(ctx, instance)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if !admitted {
+		logger.Info("Skipping LoadTest: does not match operator's PodIntegrationOptions", "LoadTest.Name", instance.Name)
+		return ctrl.Result{}, nil
+	}
+
+	exec := r.executorFor(instance)
+
+	handle, err := exec.Provision(ctx, instance)
+	if errors.Is(err, errWaitingForOwnerAdoption) {
+		logger.Info("Waiting for Spec.OwnerRef's controller to create the worker workload", "LoadTest.Name", instance.Name, "OwnerRef", instance.Spec.OwnerRef)
+		return ctrl.Result{RequeueAfter: ownerAdoptionRequeueInterval}, nil
+	}
+	if err != nil {
+		logger.Error(err, "Failed to provision LoadTest workers", "LoadTest.Name", instance.Name)
+		return ctrl.Result{}, err
+	}
+
+	status, err := exec.Status(handle)
+	if err != nil {
+		logger.Error(err, "Failed to get LoadTest worker status", "LoadTest.Name", instance.Name)
+		return ctrl.Result{}, err
+	}
+
+	// The kubernetes driver already persists Active/Succeeded/Failed/Ready (and
+	// the uncounted-pods bookkeeping backing them) from inside exec.Status via
+	// reconcileWorkerPods, so `instance` as fetched above may now be stale.
+	// Re-fetch before comparing/writing so this update carries the current
+	// resourceVersion instead of racing the one the executor already made.
+	current := &lt.LoadTest{}
+	if err := r.Get(ctx, req.NamespacedName, current); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if current.Status.Active != status.Active ||
+		current.Status.Succeeded != status.Succeeded ||
+		current.Status.Failed != status.Failed ||
+		current.Status.Ready != status.Ready {
+
+		current.Status.Active = status.Active
+		current.Status.Succeeded = status.Succeeded
+		current.Status.Failed = status.Failed
+		current.Status.Ready = status.Ready
+
+		if err := r.Status().Update(ctx, current); err != nil {
+			logger.Error(err, "Failed to update LoadTest status", "LoadTest.Name", instance.Name)
+			return ctrl.Result{}, err
+		}
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *LoadTestReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&lt.LoadTest{}).
+		Owns(&v1.Job{}).
+		Owns(&v1.CronJob{}).
+		Owns(&appsv1.StatefulSet{}).
+		Watches(
+			&corev1.Pod{},
+			handler.EnqueueRequestsFromMapFunc(loadTestRequestForWorkerPod),
+		).
+		Complete(r)
+}
+
+// loadTestRequestForWorkerPod maps a worker Pod event back to a reconcile
+// request for the LoadTest it belongs to, using the "artillery.io/test-name"
+// label attached by labels() - Pods are owned by their Job, not the LoadTest
+// directly, so they can't be tracked via Owns().
+func loadTestRequestForWorkerPod(_ context.Context, obj client.Object) []ctrl.Request {
+	testName, ok := obj.GetLabels()["artillery.io/test-name"]
+	if !ok {
+		return nil
+	}
+
+	return []ctrl.Request{
+		{
+			NamespacedName: types.NamespacedName{
+				Name:      testName,
+				Namespace: obj.GetNamespace(),
+			},
+		},
+	}
+}
@@ -0,0 +1,45 @@
+/*
+ * Copyright (c) 2021-2022.
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0.
+ *
+ * If a copy of the MPL was not distributed with
+ * this file, You can obtain one at
+ *
+ *   http://mozilla.org/MPL/2.0/
+ */
+
+package controllers
+
+import (
+	"context"
+
+	lt "github.com/artilleryio/artillery-operator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+//+kubebuilder:rbac:groups=core,resources=namespaces,verbs=get
+
+// admitted reports whether this operator instance is allowed to reconcile
+// instance: its namespace must match PodIntegration.NamespaceSelector and the
+// worker Pod template's labels must match PodIntegration.PodSelector. The two
+// gates are combined into the one result, which is false if either fails. Both gates
+// default to "match everything" when unset, preserving single-tenant behavior.
+// The namespace lookup is skipped entirely when NamespaceSelector is unset, so
+// the default single-tenant config never needs "get" on core/namespaces.
+func (r *LoadTestReconciler) admitted(ctx context.Context, instance *lt.LoadTest) (bool, error) {
+	if r.PodIntegration.NamespaceSelector != nil {
+		namespace := &corev1.Namespace{}
+		if err := r.Get(ctx, types.NamespacedName{Name: instance.Namespace}, namespace); err != nil {
+			return false, err
+		}
+
+		if !r.PodIntegration.MatchesNamespace(namespace.Labels) {
+			return false, nil
+		}
+	}
+
+	return r.PodIntegration.MatchesPod(labels(instance, "loadtest-worker")), nil
+}
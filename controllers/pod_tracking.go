@@ -0,0 +1,287 @@
+/*
+ * Copyright (c) 2021-2022.
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0.
+ *
+ * If a copy of the MPL was not distributed with
+ * this file, You can obtain one at
+ *
+ *   http://mozilla.org/MPL/2.0/
+ */
+
+package controllers
+
+import (
+	"context"
+
+	lt "github.com/artilleryio/artillery-operator/api/v1alpha1"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	k8error "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// WorkerFinalizer is attached to every worker Pod on creation and is only
+// removed once the Pod's terminal outcome (success or failure) has been
+// folded into LoadTestStatus. This guarantees a `count: N` load test always
+// accounts for all N workers, even across a controller restart that lands
+// between a Pod finishing and its outcome being counted.
+const WorkerFinalizer = "artillery.io/worker-finalizer"
+
+// LoadTestFinalizer blocks a LoadTest's deletion until its worker Pods have
+// had WorkerFinalizer stripped from them. Without it, deleting a LoadTest
+// cascade-deletes its Job/CronJob/StatefulSet (and so its Pods) while any
+// still-running or not-yet-counted worker Pod is still wedged in
+// "Terminating" by WorkerFinalizer, forever.
+const LoadTestFinalizer = "artillery.io/loadtest-finalizer"
+
+// releaseWorkerPods strips WorkerFinalizer from every worker Pod belonging to
+// instance, regardless of phase. It is called while instance itself is
+// terminating, so - unlike reconcileWorkerPods - it makes no attempt to fold
+// outcomes into LoadTestStatus first: the LoadTest is on its way out either way.
+func (r *LoadTestReconciler) releaseWorkerPods(
+	ctx context.Context,
+	instance *lt.LoadTest,
+	logger logr.Logger,
+) error {
+	var pods corev1.PodList
+	if err := r.List(ctx, &pods,
+		client.InNamespace(instance.Namespace),
+		client.MatchingLabels(labels(instance, "loadtest-worker")),
+	); err != nil {
+		logger.Error(err, "Failed to list worker Pods", "LoadTest.Name", instance.Name)
+		return err
+	}
+
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if !controllerutil.ContainsFinalizer(pod, WorkerFinalizer) {
+			continue
+		}
+
+		controllerutil.RemoveFinalizer(pod, WorkerFinalizer)
+		if err := r.Update(ctx, pod); err != nil && !k8error.IsNotFound(err) {
+			logger.Error(err, "Failed to release worker finalizer", "Pod.Name", pod.Name)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// reconcileWorkerPods attaches WorkerFinalizer to worker Pods that don't have
+// it yet, and folds the outcome of terminated worker Pods into the LoadTest's
+// status. A terminated Pod's UID is recorded in Status.UncountedTerminatedPods,
+// and countTerminatedPods only moves it into the corresponding counter once
+// its finalizer has actually been removed, so a crash at any point during
+// this sequence is recoverable on the next reconcile. A worker Pod that
+// already carries a DeletionTimestamp (e.g. deleted out-of-band, independent
+// of the LoadTest itself) has its finalizer released unconditionally, since
+// it's leaving one way or another. It is workload-agnostic: workers are
+// discovered by the "loadtest-worker" label regardless of whether they were
+// spawned by a Job, CronJob or StatefulSet.
+func (r *LoadTestReconciler) reconcileWorkerPods(
+	ctx context.Context,
+	instance *lt.LoadTest,
+	logger logr.Logger,
+) error {
+	var pods corev1.PodList
+	if err := r.List(ctx, &pods,
+		client.InNamespace(instance.Namespace),
+		client.MatchingLabels(labels(instance, "loadtest-worker")),
+	); err != nil {
+		logger.Error(err, "Failed to list worker Pods", "LoadTest.Name", instance.Name)
+		return err
+	}
+
+	if instance.Status.UncountedTerminatedPods == nil {
+		instance.Status.UncountedTerminatedPods = &lt.UncountedTerminatedPods{}
+	}
+
+	statusChanged := false
+	var active, ready int32
+
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+
+		terminal := pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed
+
+		if pod.DeletionTimestamp != nil {
+			if controllerutil.ContainsFinalizer(pod, WorkerFinalizer) {
+				controllerutil.RemoveFinalizer(pod, WorkerFinalizer)
+				if err := r.Update(ctx, pod); err != nil {
+					logger.Error(err, "Failed to release worker finalizer", "Pod.Name", pod.Name)
+					return err
+				}
+			}
+			continue
+		}
+
+		if !terminal && !controllerutil.ContainsFinalizer(pod, WorkerFinalizer) {
+			controllerutil.AddFinalizer(pod, WorkerFinalizer)
+			if err := r.Update(ctx, pod); err != nil {
+				logger.Error(err, "Failed to attach worker finalizer", "Pod.Name", pod.Name)
+				return err
+			}
+		}
+
+		// A terminal Pod that no longer carries WorkerFinalizer has already
+		// been folded into Status.Succeeded/Failed by a prior reconcile; skip
+		// it so its outcome isn't counted again.
+		switch {
+		case pod.Status.Phase == corev1.PodSucceeded && controllerutil.ContainsFinalizer(pod, WorkerFinalizer):
+			if recordUncounted(&instance.Status.UncountedTerminatedPods.Succeeded, pod.UID) {
+				statusChanged = true
+			}
+		case pod.Status.Phase == corev1.PodFailed && controllerutil.ContainsFinalizer(pod, WorkerFinalizer):
+			if recordUncounted(&instance.Status.UncountedTerminatedPods.Failed, pod.UID) {
+				statusChanged = true
+			}
+		case pod.Status.Phase == corev1.PodRunning:
+			active++
+			if podReady(pod) {
+				ready++
+			}
+		}
+	}
+
+	if instance.Status.Active != active || instance.Status.Ready != ready {
+		instance.Status.Active = active
+		instance.Status.Ready = ready
+		statusChanged = true
+	}
+
+	if statusChanged {
+		if err := r.Status().Update(ctx, instance); err != nil {
+			logger.Error(err, "Failed to record uncounted terminated Pods", "LoadTest.Name", instance.Name)
+			return err
+		}
+	}
+
+	return r.countTerminatedPods(ctx, instance, &pods, logger)
+}
+
+// countTerminatedPods releases WorkerFinalizer from every Pod still in
+// Status.UncountedTerminatedPods *before* moving its UID into the
+// corresponding Succeeded/Failed counter. This order matters: a terminal Pod
+// that still carries WorkerFinalizer is, by construction, one
+// reconcileWorkerPods will keep re-recording as uncounted, so the counter
+// increment must not be persisted until the finalizer removal that makes the
+// Pod un-re-recordable has itself been persisted. Otherwise a crash between
+// "counter incremented" and "finalizer removed" leaves the Pod both counted
+// and still eligible to be recorded - and counted - again on the next
+// reconcile. Once a Pod's finalizer is gone, it's deleted if it matches
+// instance.Spec.CleanPodPolicy.
+func (r *LoadTestReconciler) countTerminatedPods(
+	ctx context.Context,
+	instance *lt.LoadTest,
+	pods *corev1.PodList,
+	logger logr.Logger,
+) error {
+	uncounted := instance.Status.UncountedTerminatedPods
+	if uncounted == nil || (len(uncounted.Succeeded) == 0 && len(uncounted.Failed) == 0) {
+		return nil
+	}
+
+	toCleanUp := append(
+		cleanUpEntries(uncounted.Succeeded, true),
+		cleanUpEntries(uncounted.Failed, false)...,
+	)
+
+	for _, entry := range toCleanUp {
+		pod := findPodByUID(pods, entry.uid)
+		if pod == nil {
+			continue
+		}
+
+		if controllerutil.ContainsFinalizer(pod, WorkerFinalizer) {
+			controllerutil.RemoveFinalizer(pod, WorkerFinalizer)
+			if err := r.Update(ctx, pod); err != nil {
+				logger.Error(err, "Failed to remove worker finalizer", "Pod.Name", pod.Name)
+				return err
+			}
+		}
+
+		if !shouldCleanUpPod(instance.Spec.CleanPodPolicy, entry.succeeded) {
+			continue
+		}
+
+		if err := r.Delete(ctx, pod); err != nil && !k8error.IsNotFound(err) {
+			logger.Error(err, "Failed to clean up finished worker Pod", "Pod.Name", pod.Name)
+			return err
+		}
+	}
+
+	instance.Status.Succeeded += int32(len(uncounted.Succeeded))
+	instance.Status.Failed += int32(len(uncounted.Failed))
+	uncounted.Succeeded = nil
+	uncounted.Failed = nil
+
+	if err := r.Status().Update(ctx, instance); err != nil {
+		logger.Error(err, "Failed to move uncounted terminated Pods into counters")
+		return err
+	}
+
+	return nil
+}
+
+type terminatedPod struct {
+	uid       types.UID
+	succeeded bool
+}
+
+func cleanUpEntries(uids []types.UID, succeeded bool) []terminatedPod {
+	entries := make([]terminatedPod, len(uids))
+	for i, uid := range uids {
+		entries[i] = terminatedPod{uid: uid, succeeded: succeeded}
+	}
+	return entries
+}
+
+// shouldCleanUpPod reports whether a finished Pod should be deleted under policy.
+func shouldCleanUpPod(policy lt.CleanPodPolicy, succeeded bool) bool {
+	switch policy {
+	case lt.CleanPodPolicyAll:
+		return true
+	case lt.CleanPodPolicyOnCompletion:
+		return succeeded
+	case lt.CleanPodPolicyOnFailure:
+		return !succeeded
+	default:
+		return false
+	}
+}
+
+// recordUncounted appends uid to list if it isn't already present, reporting
+// whether it added a new entry.
+func recordUncounted(list *[]types.UID, uid types.UID) bool {
+	for _, existing := range *list {
+		if existing == uid {
+			return false
+		}
+	}
+	*list = append(*list, uid)
+	return true
+}
+
+func findPodByUID(pods *corev1.PodList, uid types.UID) *corev1.Pod {
+	for i := range pods.Items {
+		if pods.Items[i].UID == uid {
+			return &pods.Items[i]
+		}
+	}
+	return nil
+}
+
+func podReady(pod *corev1.Pod) bool {
+	for _, cond := range pod.Status.Conditions {
+		if cond.Type == corev1.PodReady {
+			return cond.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
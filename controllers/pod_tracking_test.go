@@ -0,0 +1,96 @@
+/*
+ * Copyright (c) 2021-2022.
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0.
+ *
+ * If a copy of the MPL was not distributed with
+ * this file, You can obtain one at
+ *
+ *   http://mozilla.org/MPL/2.0/
+ */
+
+package controllers
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestRecordUncounted(t *testing.T) {
+	var list []types.UID
+
+	if added := recordUncounted(&list, "pod-a"); !added {
+		t.Fatalf("recordUncounted() = false, want true for a new UID")
+	}
+	if len(list) != 1 {
+		t.Fatalf("list = %v, want 1 entry", list)
+	}
+
+	if added := recordUncounted(&list, "pod-a"); added {
+		t.Fatalf("recordUncounted() = true, want false for a UID already in the list")
+	}
+	if len(list) != 1 {
+		t.Fatalf("list = %v, want still 1 entry after a duplicate", list)
+	}
+
+	if added := recordUncounted(&list, "pod-b"); !added {
+		t.Fatalf("recordUncounted() = false, want true for a second new UID")
+	}
+	if len(list) != 2 {
+		t.Fatalf("list = %v, want 2 entries", list)
+	}
+}
+
+func TestFindPodByUID(t *testing.T) {
+	pods := &corev1.PodList{
+		Items: []corev1.Pod{
+			{ObjectMeta: metav1.ObjectMeta{Name: "a", UID: "uid-a"}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "b", UID: "uid-b"}},
+		},
+	}
+
+	if pod := findPodByUID(pods, "uid-b"); pod == nil || pod.Name != "b" {
+		t.Fatalf("findPodByUID(uid-b) = %v, want Pod \"b\"", pod)
+	}
+
+	if pod := findPodByUID(pods, "missing"); pod != nil {
+		t.Fatalf("findPodByUID(missing) = %v, want nil", pod)
+	}
+}
+
+func TestPodReady(t *testing.T) {
+	tests := map[string]struct {
+		conditions []corev1.PodCondition
+		want       bool
+	}{
+		"ready true":          {[]corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}}, true},
+		"ready false":         {[]corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionFalse}}, false},
+		"no ready condition":  {[]corev1.PodCondition{{Type: corev1.PodScheduled, Status: corev1.ConditionTrue}}, false},
+		"no conditions":       {nil, false},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			pod := &corev1.Pod{Status: corev1.PodStatus{Conditions: tc.conditions}}
+			if got := podReady(pod); got != tc.want {
+				t.Errorf("podReady() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestCleanUpEntries(t *testing.T) {
+	entries := cleanUpEntries([]types.UID{"a", "b"}, true)
+	if len(entries) != 2 {
+		t.Fatalf("cleanUpEntries() = %v, want 2 entries", entries)
+	}
+	for _, e := range entries {
+		if !e.succeeded {
+			t.Errorf("entry %+v, want succeeded=true", e)
+		}
+	}
+}
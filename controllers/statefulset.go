@@ -0,0 +1,139 @@
+/*
+ * Copyright (c) 2021-2022.
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0.
+ *
+ * If a copy of the MPL was not distributed with
+ * this file, You can obtain one at
+ *
+ *   http://mozilla.org/MPL/2.0/
+ */
+
+package controllers
+
+import (
+	"context"
+
+	lt "github.com/artilleryio/artillery-operator/api/v1alpha1"
+	"github.com/go-logr/logr"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8error "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// statefulSetBuilder is the workloadBuilder for Spec.WorkloadType ==
+// StatefulSet: workers get stable identities and, unlike a Job's Pods, are
+// not recreated from scratch on restart - suited to long-running soak tests.
+type statefulSetBuilder struct {
+	r *LoadTestReconciler
+}
+
+// statefulSet creates a StatefulSet spec based on the LoadTest Custom Resource.
+func (b *statefulSetBuilder) statefulSet(v *lt.LoadTest, logger logr.Logger) *appsv1.StatefulSet {
+	var replicas int32 = 1
+	if v.Spec.Count > 0 {
+		replicas = int32(v.Spec.Count)
+	}
+
+	// StatefulSet Pods may only use RestartPolicyAlways; unlike Job/CronJob
+	// workers, these Pods never reach PodSucceeded/PodFailed on their own, so
+	// reconcileWorkerPods' phase-based Succeeded/Failed accounting stays at
+	// zero for soak tests - only Active/Ready are meaningful here.
+	template := workerPodTemplateSpec(v, logger, b.r.TelemetryConfig, corev1.RestartPolicyAlways)
+
+	statefulSet := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      v.Name,
+			Namespace: v.Namespace,
+			Labels:    labels(v, "loadtest-worker-master"),
+		},
+		Spec: appsv1.StatefulSetSpec{
+			Replicas:    &replicas,
+			ServiceName: v.Name,
+			Selector: &metav1.LabelSelector{
+				MatchLabels: labels(v, "loadtest-worker"),
+			},
+			Template: template,
+		},
+	}
+
+	_ = ctrl.SetControllerReference(v, statefulSet, b.r.Scheme)
+	return statefulSet
+}
+
+func (b *statefulSetBuilder) Ensure(ctx context.Context, instance *lt.LoadTest, logger logr.Logger) (*reconcile.Result, error) {
+	statefulSet := b.statefulSet(instance, logger)
+
+	found := &appsv1.StatefulSet{}
+	err := b.r.Get(ctx, types.NamespacedName{Name: statefulSet.Name, Namespace: instance.Namespace}, found)
+
+	if err != nil && k8error.IsNotFound(err) {
+		if instance.Spec.OwnerRef != nil {
+			owned, findErr := findOwnedStatefulSet(ctx, b.r.Client, instance.Namespace, instance.Spec.OwnerRef)
+			if findErr != nil {
+				logger.Error(findErr, "Failed to list StatefulSets for adoption", "OwnerRef", instance.Spec.OwnerRef)
+				return &ctrl.Result{}, findErr
+			}
+			if owned == nil {
+				return nil, errWaitingForOwnerAdoption
+			}
+
+			b.r.Recorder.Eventf(instance, "Normal", "Adopted", "Adopted externally-owned StatefulSet: %s", owned.Name)
+			return nil, nil
+		}
+
+		logger.Info("Creating a new StatefulSet", "StatefulSet.Namespace", statefulSet.Namespace, "StatefulSet.Name", statefulSet.Name)
+
+		if err := b.r.Create(ctx, statefulSet); err != nil {
+			logger.Error(err, "Failed to create new StatefulSet", "StatefulSet.Namespace", statefulSet.Namespace, "StatefulSet.Name", statefulSet.Name)
+			return &ctrl.Result{}, err
+		}
+
+		b.r.Recorder.Eventf(instance, "Normal", "Created", "Created Load Test worker StatefulSet: %s", statefulSet.Name)
+		return nil, nil
+	} else if err != nil {
+		logger.Error(err, "Failed to get StatefulSet", "StatefulSet.Namespace", statefulSet.Namespace, "StatefulSet.Name", statefulSet.Name)
+		return &ctrl.Result{}, err
+	}
+
+	if replicasOf(found) != replicasOf(statefulSet) {
+		found.Spec.Replicas = statefulSet.Spec.Replicas
+		if err := b.r.Update(ctx, found); err != nil {
+			logger.Error(err, "Failed to scale StatefulSet", "StatefulSet.Name", found.Name)
+			return &ctrl.Result{}, err
+		}
+	}
+
+	return nil, nil
+}
+
+func replicasOf(s *appsv1.StatefulSet) int32 {
+	if s.Spec.Replicas == nil {
+		return 1
+	}
+	return *s.Spec.Replicas
+}
+
+func (b *statefulSetBuilder) Teardown(ctx context.Context, instance *lt.LoadTest, logger logr.Logger) error {
+	statefulSet := &appsv1.StatefulSet{}
+	err := b.r.Get(ctx, types.NamespacedName{Name: instance.Name, Namespace: instance.Namespace}, statefulSet)
+	if k8error.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	propagation := metav1.DeletePropagationBackground
+	if err := b.r.Delete(ctx, statefulSet, &client.DeleteOptions{PropagationPolicy: &propagation}); err != nil && !k8error.IsNotFound(err) {
+		logger.Error(err, "Failed to delete StatefulSet", "StatefulSet.Name", statefulSet.Name)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,141 @@
+/*
+ * Copyright (c) 2021-2022.
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0.
+ *
+ * If a copy of the MPL was not distributed with
+ * this file, You can obtain one at
+ *
+ *   http://mozilla.org/MPL/2.0/
+ */
+
+package controllers
+
+import (
+	"errors"
+	"fmt"
+
+	lt "github.com/artilleryio/artillery-operator/api/v1alpha1"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// workerPodTemplateSpec builds the Pod template shared by every
+// workloadBuilder (Job, CronJob, StatefulSet): the Artillery worker
+// container, its resources, env, volumes and the test-name/component labels.
+// restartPolicy is left to the caller since it's workload-kind-specific:
+// Job/CronJob require RestartPolicyNever or RestartPolicyOnFailure, while
+// StatefulSet only permits RestartPolicyAlways.
+func workerPodTemplateSpec(v *lt.LoadTest, logger logr.Logger, telemetry TelemetryConfig, restartPolicy corev1.RestartPolicy) corev1.PodTemplateSpec {
+	img := WorkerImage
+
+	resources := corev1.ResourceRequirements{
+		Limits: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse("2"),
+			corev1.ResourceMemory: resource.MustParse("4Gi"),
+		},
+		Requests: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse("2"),
+			corev1.ResourceMemory: resource.MustParse("2Gi"),
+		},
+	}
+	if v.Spec.Resources != nil && v.Spec.Resources.Limits != nil {
+		resources.Limits = MergePreservingExistingKeys(v.Spec.Resources.Limits, resources.Limits)
+	}
+	if v.Spec.Resources != nil && v.Spec.Resources.Requests != nil {
+		resources.Requests = MergePreservingExistingKeys(v.Spec.Resources.Requests, resources.Requests)
+	}
+
+	if v.Spec.Image != "" {
+		img = v.Spec.Image
+	}
+
+	args := []string{
+		"help",
+	}
+
+	if v.Spec.Args != nil {
+		args = v.Spec.Args
+	}
+
+	secrets := []corev1.EnvFromSource{}
+
+	if v.Spec.SecretEnvSource != "" {
+		secrets = append(secrets, corev1.EnvFromSource{
+			SecretRef: &corev1.SecretEnvSource{
+				LocalObjectReference: corev1.LocalObjectReference{
+					Name: v.Spec.SecretEnvSource,
+				},
+			},
+		})
+	}
+
+	volumes := []corev1.Volume{}
+	volumeMounts := []corev1.VolumeMount{}
+
+	envVars := []corev1.EnvVar{
+		// published metrics use WORKER_ID to connect the pod (worker) to a Pushgateway JobID
+		// Uses the downward API:
+		// https://kubernetes.io/docs/tasks/inject-data-application/downward-api-volume-expose-pod-information/#the-downward-api
+		{
+			Name: "WORKER_ID",
+			ValueFrom: &corev1.EnvVarSource{
+				FieldRef: &corev1.ObjectFieldSelector{
+					FieldPath: "metadata.name",
+				},
+			},
+		}}
+
+	if v.Spec.SecretMount != nil {
+		sm := *v.Spec.SecretMount
+		volumes = append(volumes, corev1.Volume{
+			Name: sm.Name,
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: sm.Name,
+				},
+			},
+		})
+		if v.Spec.UsersFile == "" {
+			logger.Error(errors.New("You need to specify the UsersFile when mounting a SecretsMount"), "")
+		}
+		volumeMounts = append(volumeMounts, corev1.VolumeMount{
+			Name:      sm.Name,
+			MountPath: sm.MountPoint,
+		})
+		envVars = append(envVars, corev1.EnvVar{
+			Name:  "USERS_PAYLOAD_PATH",
+			Value: fmt.Sprintf("%s/%s", sm.MountPoint, v.Spec.UsersFile),
+		})
+
+	}
+
+	return corev1.PodTemplateSpec{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels:     labels(v, "loadtest-worker"),
+			Finalizers: []string{WorkerFinalizer},
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{
+					Name:            v.Name,
+					Image:           img,
+					ImagePullPolicy: corev1.PullIfNotPresent,
+					Resources:       resources,
+					Args:            args,
+					EnvFrom:         secrets,
+					Env: append(envVars,
+						telemetry.ToK8sEnvVar()...,
+					),
+					VolumeMounts: volumeMounts,
+				},
+			},
+			// Provides access to the ConfigMap holding the test script config
+			RestartPolicy: restartPolicy,
+			Volumes:       volumes,
+		},
+	}
+}
@@ -0,0 +1,59 @@
+/*
+ * Copyright (c) 2021-2022.
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0.
+ *
+ * If a copy of the MPL was not distributed with
+ * this file, You can obtain one at
+ *
+ *   http://mozilla.org/MPL/2.0/
+ */
+
+package controllers
+
+import (
+	"context"
+
+	lt "github.com/artilleryio/artillery-operator/api/v1alpha1"
+	"github.com/artilleryio/artillery-operator/pkg/executor"
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// workloadBuilder owns the lifecycle of the Kubernetes workload that runs a
+// LoadTest's workers: creating it and tearing it down. LoadTestReconciler
+// dispatches to the implementation matching Spec.WorkloadType instead of
+// assuming a Job. Status is not aggregated by the builder: reconcileWorkerPods
+// derives it from the worker Pods the same way for every workload kind.
+type workloadBuilder interface {
+	// Ensure makes sure the workload exists in the cluster, creating it if needed.
+	Ensure(ctx context.Context, instance *lt.LoadTest, logger logr.Logger) (*reconcile.Result, error)
+
+	// Teardown removes the workload of this builder's kind named after instance.
+	Teardown(ctx context.Context, instance *lt.LoadTest, logger logr.Logger) error
+}
+
+// workloadBuilderFor returns the workloadBuilder matching instance.Spec.WorkloadType,
+// defaulting to the Job builder used by every LoadTest before WorkloadType existed.
+func (r *LoadTestReconciler) workloadBuilderFor(instance *lt.LoadTest) workloadBuilder {
+	switch instance.Spec.WorkloadType {
+	case lt.CronJobWorkload:
+		return &cronJobBuilder{r}
+	case lt.StatefulSetWorkload:
+		return &statefulSetBuilder{r}
+	default:
+		return &jobBuilder{r}
+	}
+}
+
+// executorFor returns the Executor matching instance.Spec.Driver, defaulting
+// to the Kubernetes executor used by every LoadTest before Driver existed.
+func (r *LoadTestReconciler) executorFor(instance *lt.LoadTest) executor.Executor {
+	switch instance.Spec.Driver {
+	case lt.DockerDriver:
+		return &dockerExecutor{}
+	default:
+		return &kubernetesExecutor{r}
+	}
+}
@@ -0,0 +1,86 @@
+/*
+ * Copyright (c) 2021-2022.
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0.
+ *
+ * If a copy of the MPL was not distributed with
+ * this file, You can obtain one at
+ *
+ *   http://mozilla.org/MPL/2.0/
+ */
+
+package controllers
+
+import (
+	"testing"
+
+	lt "github.com/artilleryio/artillery-operator/api/v1alpha1"
+)
+
+func TestWorkloadBuilderFor(t *testing.T) {
+	r := &LoadTestReconciler{}
+
+	tests := map[string]struct {
+		workloadType lt.WorkloadType
+		want         interface{}
+	}{
+		"CronJob workload":      {lt.CronJobWorkload, &cronJobBuilder{}},
+		"StatefulSet workload":  {lt.StatefulSetWorkload, &statefulSetBuilder{}},
+		"Job workload":          {lt.JobWorkload, &jobBuilder{}},
+		"unset defaults to Job": {"", &jobBuilder{}},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			instance := &lt.LoadTest{Spec: lt.LoadTestSpec{WorkloadType: tc.workloadType}}
+
+			got := r.workloadBuilderFor(instance)
+			switch tc.want.(type) {
+			case *cronJobBuilder:
+				if _, ok := got.(*cronJobBuilder); !ok {
+					t.Errorf("workloadBuilderFor() = %T, want *cronJobBuilder", got)
+				}
+			case *statefulSetBuilder:
+				if _, ok := got.(*statefulSetBuilder); !ok {
+					t.Errorf("workloadBuilderFor() = %T, want *statefulSetBuilder", got)
+				}
+			case *jobBuilder:
+				if _, ok := got.(*jobBuilder); !ok {
+					t.Errorf("workloadBuilderFor() = %T, want *jobBuilder", got)
+				}
+			}
+		})
+	}
+}
+
+func TestExecutorFor(t *testing.T) {
+	r := &LoadTestReconciler{}
+
+	tests := map[string]struct {
+		driver lt.DriverType
+		want   interface{}
+	}{
+		"Docker driver":                {lt.DockerDriver, &dockerExecutor{}},
+		"Kubernetes driver":            {lt.KubernetesDriver, &kubernetesExecutor{}},
+		"unset defaults to Kubernetes": {"", &kubernetesExecutor{}},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			instance := &lt.LoadTest{Spec: lt.LoadTestSpec{Driver: tc.driver}}
+
+			got := r.executorFor(instance)
+			switch tc.want.(type) {
+			case *dockerExecutor:
+				if _, ok := got.(*dockerExecutor); !ok {
+					t.Errorf("executorFor() = %T, want *dockerExecutor", got)
+				}
+			case *kubernetesExecutor:
+				if _, ok := got.(*kubernetesExecutor); !ok {
+					t.Errorf("executorFor() = %T, want *kubernetesExecutor", got)
+				}
+			}
+		})
+	}
+}
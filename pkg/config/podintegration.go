@@ -0,0 +1,77 @@
+/*
+ * Copyright (c) 2021-2022.
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0.
+ *
+ * If a copy of the MPL was not distributed with
+ * this file, You can obtain one at
+ *
+ *   http://mozilla.org/MPL/2.0/
+ */
+
+// Package config holds operator-wide settings loaded once at manager startup,
+// as opposed to per-LoadTest settings carried on the CR's Spec.
+package config
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// PodIntegrationOptions gates which namespaces the operator reconciles
+// LoadTests in, and which worker Pods it is allowed to create, so a single
+// cluster-wide operator deployment can be shared across tenants while
+// limiting which of them can spawn load-test workloads.
+type PodIntegrationOptions struct {
+	// NamespaceSelector restricts reconciliation to LoadTests whose
+	// namespace carries matching labels. A nil selector matches every namespace.
+	NamespaceSelector labels.Selector
+
+	// PodSelector restricts worker Pod creation to Pods whose template
+	// labels satisfy this selector, in addition to the operator's own
+	// artillery.io/* labels. A nil selector matches every Pod.
+	PodSelector labels.Selector
+}
+
+// NewPodIntegrationOptions parses the (optional) namespace and Pod
+// LabelSelectors loaded from operator configuration at startup. A nil
+// metav1.LabelSelector yields a nil labels.Selector, which MatchesNamespace()
+// and MatchesPod() on PodIntegrationOptions treat as "match everything".
+func NewPodIntegrationOptions(namespaceSelector, podSelector *metav1.LabelSelector) (PodIntegrationOptions, error) {
+	opts := PodIntegrationOptions{}
+
+	if namespaceSelector != nil {
+		sel, err := metav1.LabelSelectorAsSelector(namespaceSelector)
+		if err != nil {
+			return opts, err
+		}
+		opts.NamespaceSelector = sel
+	}
+
+	if podSelector != nil {
+		sel, err := metav1.LabelSelectorAsSelector(podSelector)
+		if err != nil {
+			return opts, err
+		}
+		opts.PodSelector = sel
+	}
+
+	return opts, nil
+}
+
+// MatchesNamespace reports whether a namespace's labels satisfy NamespaceSelector.
+func (o PodIntegrationOptions) MatchesNamespace(namespaceLabels map[string]string) bool {
+	if o.NamespaceSelector == nil {
+		return true
+	}
+	return o.NamespaceSelector.Matches(labels.Set(namespaceLabels))
+}
+
+// MatchesPod reports whether a worker Pod template's labels satisfy PodSelector.
+func (o PodIntegrationOptions) MatchesPod(podLabels map[string]string) bool {
+	if o.PodSelector == nil {
+		return true
+	}
+	return o.PodSelector.Matches(labels.Set(podLabels))
+}
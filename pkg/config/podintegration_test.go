@@ -0,0 +1,88 @@
+/*
+ * Copyright (c) 2021-2022.
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0.
+ *
+ * If a copy of the MPL was not distributed with
+ * this file, You can obtain one at
+ *
+ *   http://mozilla.org/MPL/2.0/
+ */
+
+package config
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestNewPodIntegrationOptions(t *testing.T) {
+	opts, err := NewPodIntegrationOptions(
+		&metav1.LabelSelector{MatchLabels: map[string]string{"tenant": "a"}},
+		&metav1.LabelSelector{MatchLabels: map[string]string{"app": "worker"}},
+	)
+	if err != nil {
+		t.Fatalf("NewPodIntegrationOptions() error = %v, want nil", err)
+	}
+	if opts.NamespaceSelector == nil || opts.PodSelector == nil {
+		t.Fatalf("NewPodIntegrationOptions() = %+v, want both selectors set", opts)
+	}
+
+	opts, err = NewPodIntegrationOptions(nil, nil)
+	if err != nil {
+		t.Fatalf("NewPodIntegrationOptions(nil, nil) error = %v, want nil", err)
+	}
+	if opts.NamespaceSelector != nil || opts.PodSelector != nil {
+		t.Fatalf("NewPodIntegrationOptions(nil, nil) = %+v, want both selectors nil", opts)
+	}
+}
+
+func TestMatchesNamespace(t *testing.T) {
+	opts, err := NewPodIntegrationOptions(&metav1.LabelSelector{MatchLabels: map[string]string{"tenant": "a"}}, nil)
+	if err != nil {
+		t.Fatalf("NewPodIntegrationOptions() error = %v", err)
+	}
+
+	tests := map[string]struct {
+		labels map[string]string
+		want   bool
+	}{
+		"matching label":      {map[string]string{"tenant": "a"}, true},
+		"non-matching label":  {map[string]string{"tenant": "b"}, false},
+		"missing label":       {map[string]string{}, false},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := opts.MatchesNamespace(tc.labels); got != tc.want {
+				t.Errorf("MatchesNamespace(%v) = %v, want %v", tc.labels, got, tc.want)
+			}
+		})
+	}
+
+	var unset PodIntegrationOptions
+	if !unset.MatchesNamespace(map[string]string{"anything": "goes"}) {
+		t.Errorf("MatchesNamespace() with a nil NamespaceSelector = false, want true (match everything)")
+	}
+}
+
+func TestMatchesPod(t *testing.T) {
+	opts, err := NewPodIntegrationOptions(nil, &metav1.LabelSelector{MatchLabels: map[string]string{"app": "worker"}})
+	if err != nil {
+		t.Fatalf("NewPodIntegrationOptions() error = %v", err)
+	}
+
+	if !opts.MatchesPod(map[string]string{"app": "worker"}) {
+		t.Errorf("MatchesPod() with matching labels = false, want true")
+	}
+	if opts.MatchesPod(map[string]string{"app": "other"}) {
+		t.Errorf("MatchesPod() with non-matching labels = true, want false")
+	}
+
+	var unset PodIntegrationOptions
+	if !unset.MatchesPod(map[string]string{"anything": "goes"}) {
+		t.Errorf("MatchesPod() with a nil PodSelector = false, want true (match everything)")
+	}
+}
@@ -0,0 +1,65 @@
+/*
+ * Copyright (c) 2021-2022.
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0.
+ *
+ * If a copy of the MPL was not distributed with
+ * this file, You can obtain one at
+ *
+ *   http://mozilla.org/MPL/2.0/
+ */
+
+// Package executor decouples the LoadTest reconciler from Kubernetes: an
+// Executor knows how to run and observe a LoadTest's workers on some backend
+// (a Kubernetes cluster, local Docker containers, ...), so the reconcile loop
+// can stay driver-agnostic and the same LoadTest CR can be applied to a
+// cluster or dry-run on a developer laptop.
+package executor
+
+import (
+	"context"
+	"io"
+
+	lt "github.com/artilleryio/artillery-operator/api/v1alpha1"
+)
+
+// WorkerID identifies a single worker within a provisioned LoadTest, e.g. a
+// Pod name or a local container name.
+type WorkerID string
+
+// Handle identifies the workers an Executor provisioned for a LoadTest, so a
+// later Status/Logs/Teardown call can find them again without holding on to
+// the full LoadTest object.
+type Handle struct {
+	Namespace string
+	Name      string
+}
+
+// ExecutorStatus mirrors the worker counts exposed on LoadTestStatus, in
+// backend-agnostic form.
+type ExecutorStatus struct {
+	Active    int32
+	Succeeded int32
+	Failed    int32
+	Ready     int32
+}
+
+// Executor provisions and observes the workers for a LoadTest on a specific
+// backend. LoadTestReconciler hands the CR to the Executor matching
+// Spec.Driver, which derives the workers' count/args/env/secrets from it and
+// owns their lifecycle, so the reconcile loop itself never talks to the
+// backend directly.
+type Executor interface {
+	// Provision makes sure the workers described by v exist, creating them if needed.
+	Provision(ctx context.Context, v *lt.LoadTest) (Handle, error)
+
+	// Status reports the current worker counts for a previously provisioned LoadTest.
+	Status(handle Handle) (ExecutorStatus, error)
+
+	// Logs streams the logs of a single worker.
+	Logs(handle Handle, worker WorkerID) (io.ReadCloser, error)
+
+	// Teardown removes the workers provisioned for handle.
+	Teardown(handle Handle) error
+}
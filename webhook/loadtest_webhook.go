@@ -0,0 +1,199 @@
+/*
+ * Copyright (c) 2021-2022.
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0.
+ *
+ * If a copy of the MPL was not distributed with
+ * this file, You can obtain one at
+ *
+ *   http://mozilla.org/MPL/2.0/
+ */
+
+// Package webhook hosts the admission webhooks that validate and default
+// LoadTest Custom Resources before the reconciler ever sees them.
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	lt "github.com/artilleryio/artillery-operator/api/v1alpha1"
+	"github.com/artilleryio/artillery-operator/controllers"
+	corev1 "k8s.io/api/core/v1"
+	k8error "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// dns1035LabelFmt matches RFC 1035 label names: job.Name = v.Name is used
+// verbatim as a Job/Pod name, and anything else currently fails silently.
+var dns1035LabelFmt = regexp.MustCompile(`^[a-z]([-a-z0-9]*[a-z0-9])?$`)
+
+const dns1035MaxLength = 63
+
+// LoadTestWebhook validates and defaults LoadTest Custom Resources at
+// admission time, so the reconciler can assume a validated spec.
+type LoadTestWebhook struct {
+	Client client.Client
+}
+
+// SetupWebhookWithManager registers both the validating and mutating webhooks for LoadTest.
+func (w *LoadTestWebhook) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&lt.LoadTest{}).
+		WithValidator(w).
+		WithDefaulter(w).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/mutate-artillery-io-v1alpha1-loadtest,mutating=true,failurePolicy=fail,sideEffects=None,groups=artillery.io,resources=loadtests,verbs=create;update,versions=v1alpha1,name=mloadtest.kb.io,admissionReviewVersions=v1
+
+// Default implements admission.CustomDefaulter.
+func (w *LoadTestWebhook) Default(_ context.Context, obj runtime.Object) error {
+	v, ok := obj.(*lt.LoadTest)
+	if !ok {
+		return fmt.Errorf("expected a LoadTest but got %T", obj)
+	}
+
+	if v.Spec.Count <= 0 {
+		v.Spec.Count = 1
+	}
+
+	if v.Labels == nil {
+		v.Labels = map[string]string{}
+	}
+	v.Labels["artillery.io/test-name"] = v.Name
+	v.Labels["artillery.io/part-of"] = "loadtest"
+
+	if v.Spec.Resources != nil {
+		if v.Spec.Resources.Limits != nil {
+			v.Spec.Resources.Limits = controllers.MergePreservingExistingKeys(v.Spec.Resources.Limits, defaultResourceLimits())
+		}
+		if v.Spec.Resources.Requests != nil {
+			v.Spec.Resources.Requests = controllers.MergePreservingExistingKeys(v.Spec.Resources.Requests, defaultResourceRequests())
+		}
+	}
+
+	return nil
+}
+
+//+kubebuilder:webhook:path=/validate-artillery-io-v1alpha1-loadtest,mutating=false,failurePolicy=fail,sideEffects=None,groups=artillery.io,resources=loadtests,verbs=create;update,versions=v1alpha1,name=vloadtest.kb.io,admissionReviewVersions=v1
+
+// ValidateCreate implements admission.CustomValidator.
+func (w *LoadTestWebhook) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, w.validate(ctx, obj.(*lt.LoadTest))
+}
+
+// ValidateUpdate implements admission.CustomValidator.
+func (w *LoadTestWebhook) ValidateUpdate(ctx context.Context, _, newObj runtime.Object) (admission.Warnings, error) {
+	return nil, w.validate(ctx, newObj.(*lt.LoadTest))
+}
+
+// ValidateDelete implements admission.CustomValidator.
+func (w *LoadTestWebhook) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (w *LoadTestWebhook) validate(ctx context.Context, v *lt.LoadTest) error {
+	if !dns1035LabelFmt.MatchString(v.Name) || len(v.Name) > dns1035MaxLength {
+		return fmt.Errorf("metadata.name %q must be a valid RFC 1035 label: it is used verbatim as the worker Job/Pod name", v.Name)
+	}
+
+	if v.Spec.Count < 0 {
+		return fmt.Errorf("spec.count must be >= 0, got %d", v.Spec.Count)
+	}
+
+	if v.Spec.SecretMount != nil && v.Spec.UsersFile == "" {
+		return fmt.Errorf("spec.usersFile is required when spec.secretMount is set")
+	}
+
+	if err := w.validateResources(v); err != nil {
+		return err
+	}
+
+	return w.validateSecretReferences(ctx, v)
+}
+
+func (w *LoadTestWebhook) validateResources(v *lt.LoadTest) error {
+	if v.Spec.Resources == nil {
+		return nil
+	}
+
+	// MergePreservingExistingKeys mutates dest in place; validation must not
+	// change the object under review, so merge into copies of the spec's maps
+	// rather than the maps themselves.
+	limits := controllers.MergePreservingExistingKeys(copyResourceList(v.Spec.Resources.Limits), defaultResourceLimits())
+	requests := controllers.MergePreservingExistingKeys(copyResourceList(v.Spec.Resources.Requests), defaultResourceRequests())
+
+	// Note: a spec that sets only a low explicit Limits[cpu] and omits
+	// Requests entirely is rejected here, since the merge fills Requests[cpu]
+	// from defaultResourceRequests() (2 cores) - this is intentional, since
+	// admitting it would hand the Pod a requests>limits spec the API server
+	// rejects anyway, just later and less legibly.
+	for name, req := range requests {
+		if limit, ok := limits[name]; ok && req.Cmp(limit) > 0 {
+			return fmt.Errorf("spec.resources.requests[%s] (%s) must not exceed spec.resources.limits[%s] (%s)", name, req.String(), name, limit.String())
+		}
+	}
+
+	return nil
+}
+
+func (w *LoadTestWebhook) validateSecretReferences(ctx context.Context, v *lt.LoadTest) error {
+	if v.Spec.SecretEnvSource != "" {
+		if err := w.secretExists(ctx, v.Namespace, v.Spec.SecretEnvSource); err != nil {
+			return err
+		}
+	}
+
+	if v.Spec.SecretMount != nil {
+		if err := w.secretExists(ctx, v.Namespace, v.Spec.SecretMount.Name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (w *LoadTestWebhook) secretExists(ctx context.Context, namespace, name string) error {
+	secret := &corev1.Secret{}
+	err := w.Client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, secret)
+	if k8error.IsNotFound(err) {
+		return fmt.Errorf("secret %q referenced by this LoadTest does not exist in namespace %q", name, namespace)
+	}
+	return err
+}
+
+// copyResourceList returns a shallow copy of list so callers can pass it
+// through a map-mutating helper without affecting the caller's original.
+func copyResourceList(list map[corev1.ResourceName]resource.Quantity) map[corev1.ResourceName]resource.Quantity {
+	if list == nil {
+		return nil
+	}
+
+	cp := make(map[corev1.ResourceName]resource.Quantity, len(list))
+	for k, v := range list {
+		cp[k] = v
+	}
+	return cp
+}
+
+func defaultResourceLimits() map[corev1.ResourceName]resource.Quantity {
+	return map[corev1.ResourceName]resource.Quantity{
+		corev1.ResourceCPU:    resource.MustParse("2"),
+		corev1.ResourceMemory: resource.MustParse("4Gi"),
+	}
+}
+
+func defaultResourceRequests() map[corev1.ResourceName]resource.Quantity {
+	return map[corev1.ResourceName]resource.Quantity{
+		corev1.ResourceCPU:    resource.MustParse("2"),
+		corev1.ResourceMemory: resource.MustParse("2Gi"),
+	}
+}
@@ -0,0 +1,148 @@
+/*
+ * Copyright (c) 2021-2022.
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0.
+ *
+ * If a copy of the MPL was not distributed with
+ * this file, You can obtain one at
+ *
+ *   http://mozilla.org/MPL/2.0/
+ */
+
+package webhook
+
+import (
+	"context"
+	"testing"
+
+	lt "github.com/artilleryio/artillery-operator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestValidate(t *testing.T) {
+	tests := map[string]struct {
+		loadTest *lt.LoadTest
+		wantErr  bool
+	}{
+		"valid name and count": {
+			loadTest: &lt.LoadTest{ObjectMeta: validMeta(), Spec: lt.LoadTestSpec{Count: 1}},
+			wantErr:  false,
+		},
+		"name with uppercase is not a valid RFC 1035 label": {
+			loadTest: &lt.LoadTest{ObjectMeta: meta("Invalid-Name"), Spec: lt.LoadTestSpec{Count: 1}},
+			wantErr:  true,
+		},
+		"name over 63 characters is rejected": {
+			loadTest: &lt.LoadTest{ObjectMeta: meta(tooLongName()), Spec: lt.LoadTestSpec{Count: 1}},
+			wantErr:  true,
+		},
+		"negative count is rejected": {
+			loadTest: &lt.LoadTest{ObjectMeta: validMeta(), Spec: lt.LoadTestSpec{Count: -1}},
+			wantErr:  true,
+		},
+		"secretMount without usersFile is rejected": {
+			loadTest: &lt.LoadTest{
+				ObjectMeta: validMeta(),
+				Spec: lt.LoadTestSpec{
+					Count:       1,
+					SecretMount: &lt.SecretMount{Name: "creds"},
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	w := &LoadTestWebhook{}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := w.validate(context.Background(), tc.loadTest)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("validate() error = %v, wantErr %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateResources(t *testing.T) {
+	tests := map[string]struct {
+		resources *corev1.ResourceRequirements
+		wantErr   bool
+	}{
+		"nil resources is fine": {
+			resources: nil,
+			wantErr:   false,
+		},
+		"explicit requests within explicit limits": {
+			resources: &corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+				Limits:   corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2")},
+			},
+			wantErr: false,
+		},
+		"explicit requests exceeding explicit limits": {
+			resources: &corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("4")},
+				Limits:   corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2")},
+			},
+			wantErr: true,
+		},
+		"only a low limit set - default requests fill in and exceed it": {
+			resources: &corev1.ResourceRequirements{
+				Limits: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+			},
+			wantErr: true,
+		},
+	}
+
+	w := &LoadTestWebhook{}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			v := &lt.LoadTest{Spec: lt.LoadTestSpec{Resources: tc.resources}}
+
+			err := w.validateResources(v)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("validateResources() error = %v, wantErr %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateResourcesDoesNotMutateSpec(t *testing.T) {
+	w := &LoadTestWebhook{}
+	v := &lt.LoadTest{
+		Spec: lt.LoadTestSpec{
+			Resources: &corev1.ResourceRequirements{
+				Limits: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("4")},
+			},
+		},
+	}
+
+	if err := w.validateResources(v); err != nil {
+		t.Fatalf("validateResources() error = %v, want nil", err)
+	}
+
+	if _, ok := v.Spec.Resources.Limits[corev1.ResourceMemory]; ok {
+		t.Errorf("validateResources() mutated the LoadTest's Limits in place; defaults must only be merged into a copy")
+	}
+}
+
+func validMeta() metav1.ObjectMeta {
+	return metav1.ObjectMeta{Name: "valid-name"}
+}
+
+func meta(name string) metav1.ObjectMeta {
+	return metav1.ObjectMeta{Name: name}
+}
+
+func tooLongName() string {
+	name := make([]byte, 64)
+	for i := range name {
+		name[i] = 'a'
+	}
+	return string(name)
+}